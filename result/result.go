@@ -0,0 +1,33 @@
+// Package result 提供throw/try的非panic版本：所有函数都返回error而非panic，
+// 便于在不适合跨API边界panic的场景（库代码、errgroup.Group.Go等）中渐进式使用本库。
+package result
+
+import "github.com/liu-dc/exception/throw"
+
+// Check 将一个普通error规整为throw.Error返回（FallbackErrorIndex），err为nil时原样返回nil
+func Check(err error) error {
+	return CheckIndex(throw.FallbackErrorIndex, err)
+}
+
+// CheckIndex 将一个普通error规整为携带指定异常码的throw.Error返回，err为nil时原样返回nil
+func CheckIndex(index int, err error) error {
+	if err == nil {
+		return nil
+	}
+	e := throw.Error{
+		Index:   index,
+		Message: err.Error(),
+	}
+	e.Cause = err
+	return e
+}
+
+// Value 在不panic的前提下规整一个(T, error)返回值，err为nil时v原样透传
+func Value[T any](v T, err error) (T, error) {
+	return v, Check(err)
+}
+
+// ValueIndex 同Value，但允许指定异常码
+func ValueIndex[T any](index int, v T, err error) (T, error) {
+	return v, CheckIndex(index, err)
+}
@@ -0,0 +1,58 @@
+package result
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/liu-dc/exception/throw"
+)
+
+func TestCheckNil(t *testing.T) {
+	if err := Check(nil); err != nil {
+		t.Errorf("Expected nil, got %v", err)
+	}
+}
+
+func TestCheckWrapsError(t *testing.T) {
+	cause := errors.New("boom")
+	err := Check(cause)
+	if err == nil {
+		t.Fatal("Expected non-nil error")
+	}
+	e, ok := err.(throw.Error)
+	if !ok {
+		t.Fatalf("Expected throw.Error, got %T", err)
+	}
+	if e.Index != throw.FallbackErrorIndex {
+		t.Errorf("Expected FallbackErrorIndex, got %d", e.Index)
+	}
+	if !errors.Is(err, cause) {
+		t.Error("Expected errors.Is to find the original cause")
+	}
+}
+
+func TestCheckIndex(t *testing.T) {
+	err := CheckIndex(404, errors.New("not found"))
+	e, ok := err.(throw.Error)
+	if !ok {
+		t.Fatalf("Expected throw.Error, got %T", err)
+	}
+	if e.Index != 404 {
+		t.Errorf("Expected index 404, got %d", e.Index)
+	}
+}
+
+func TestValue(t *testing.T) {
+	v, err := Value(42, nil)
+	if err != nil {
+		t.Errorf("Expected nil error, got %v", err)
+	}
+	if v != 42 {
+		t.Errorf("Expected 42, got %d", v)
+	}
+
+	_, err = Value(0, errors.New("failed"))
+	if err == nil {
+		t.Error("Expected non-nil error")
+	}
+}
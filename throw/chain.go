@@ -0,0 +1,68 @@
+package throw
+
+import "errors"
+
+// Unwrap 解包原始异常，支持 errors.Is / errors.As 沿着异常链追溯
+// 当存在多个原始异常（Causes，对应Join场景）时返回全部；否则返回单个Cause（若有）
+func (e Error) Unwrap() []error {
+	if len(e.Causes) > 0 {
+		if e.Cause == nil {
+			return e.Causes
+		}
+		return append([]error{e.Cause}, e.Causes...)
+	}
+	if e.Cause != nil {
+		return []error{e.Cause}
+	}
+	return nil
+}
+
+// Is 支持 errors.Is(caught, target)：target为Error且Index与Message均相同时判定相等
+// Cause/Causes链的追溯不在此处进行，而由errors.Is经Unwrap完成
+func (e Error) Is(target error) bool {
+	t, ok := target.(Error)
+	if !ok {
+		return false
+	}
+	return e.Index == t.Index && e.Message == t.Message
+}
+
+// As 支持 errors.As 将Error赋值给目标类型
+func (e Error) As(target interface{}) bool {
+	if p, ok := target.(*Error); ok {
+		*p = e
+		return true
+	}
+	return false
+}
+
+// Wrap 包装一个已有异常并抛出（含堆栈信息），保留原始异常供 errors.Is/As 使用
+func Wrap(err error, index int, message string) {
+	e := newError(index, message)
+	e.Cause = err
+	panic(e)
+}
+
+// WrapWithContext 带上下文的Wrap函数
+func WrapWithContext(err error, index int, message string, key string, ctxValue interface{}) {
+	e := newError(index, message)
+	e.Cause = err
+	panic(e.WithContext(key, ctxValue))
+}
+
+// Join 合并多个异常并抛出一个携带完整异常链的Error
+// errs中的nil会被忽略；全部为nil时不会panic（与 errors.Join 行为保持一致）
+func Join(errs ...error) {
+	causes := make([]error, 0, len(errs))
+	for _, err := range errs {
+		if err != nil {
+			causes = append(causes, err)
+		}
+	}
+	if len(causes) == 0 {
+		return
+	}
+	e := newError(FallbackErrorIndex, errors.Join(causes...).Error())
+	e.Causes = causes
+	panic(e)
+}
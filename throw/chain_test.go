@@ -0,0 +1,130 @@
+package throw
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+)
+
+func TestWrapUnwrapIs(t *testing.T) {
+	defer func() {
+		r := recover()
+		e, ok := r.(Error)
+		if !ok {
+			t.Fatalf("Expected Error type, got %T", r)
+		}
+		if !errors.Is(e, sql.ErrNoRows) {
+			t.Error("Expected errors.Is to find wrapped sql.ErrNoRows")
+		}
+	}()
+
+	Wrap(sql.ErrNoRows, 404, "record not found")
+}
+
+func TestJoinUnwrap(t *testing.T) {
+	err1 := errors.New("first error")
+	err2 := errors.New("second error")
+
+	defer func() {
+		r := recover()
+		e, ok := r.(Error)
+		if !ok {
+			t.Fatalf("Expected Error type, got %T", r)
+		}
+		if !errors.Is(e, err1) || !errors.Is(e, err2) {
+			t.Error("Expected errors.Is to find both joined causes")
+		}
+	}()
+
+	Join(err1, err2)
+}
+
+func TestJoinAllNilIsNoop(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("Expected no panic when all errors are nil, got %v", r)
+		}
+	}()
+
+	Join(nil, nil)
+}
+
+func TestErrPreservesCause(t *testing.T) {
+	cause := errors.New("db timeout")
+
+	defer func() {
+		r := recover()
+		e, ok := r.(Error)
+		if !ok {
+			t.Fatalf("Expected Error type, got %T", r)
+		}
+		if !errors.Is(e, cause) {
+			t.Error("Expected errors.Is to find the wrapped cause via Err")
+		}
+	}()
+
+	Err(cause)
+}
+
+// recoverError 测试辅助函数：执行fn并返回其panic出的Error，非Error类型的panic会使测试失败
+func recoverError(t *testing.T, fn func()) (e Error) {
+	t.Helper()
+	defer func() {
+		r := recover()
+		var ok bool
+		e, ok = r.(Error)
+		if !ok {
+			t.Fatalf("Expected Error type, got %T", r)
+		}
+	}()
+	fn()
+	return
+}
+
+func TestIndexErrPreservesCause(t *testing.T) {
+	cause := errors.New("lookup failed")
+	e := recoverError(t, func() { IndexErr(500, cause) })
+	if !errors.Is(e, cause) {
+		t.Error("Expected errors.Is to find the wrapped cause via IndexErr")
+	}
+}
+
+func TestIndexErrWithContextPreservesCause(t *testing.T) {
+	cause := errors.New("lookup failed")
+	e := recoverError(t, func() { IndexErrWithContext(500, cause, "key", "value") })
+	if !errors.Is(e, cause) {
+		t.Error("Expected errors.Is to find the wrapped cause via IndexErrWithContext")
+	}
+}
+
+func TestFuncErrPreservesCause(t *testing.T) {
+	cause := errors.New("operation failed")
+	e := recoverError(t, func() { FuncErr(func() error { return cause }) })
+	if !errors.Is(e, cause) {
+		t.Error("Expected errors.Is to find the wrapped cause via FuncErr")
+	}
+}
+
+func TestFuncErrWithContextPreservesCause(t *testing.T) {
+	cause := errors.New("operation failed")
+	e := recoverError(t, func() { FuncErrWithContext(func() error { return cause }, "key", "value") })
+	if !errors.Is(e, cause) {
+		t.Error("Expected errors.Is to find the wrapped cause via FuncErrWithContext")
+	}
+}
+
+func TestValueErrPreservesCause(t *testing.T) {
+	cause := errors.New("parse failed")
+	e := recoverError(t, func() { ValueErr(0, cause) })
+	if !errors.Is(e, cause) {
+		t.Error("Expected errors.Is to find the wrapped cause via ValueErr")
+	}
+}
+
+func TestValueErrWithContextPreservesCause(t *testing.T) {
+	cause := errors.New("parse failed")
+	e := recoverError(t, func() { ValueErrWithContext(0, cause, "key", "value") })
+	if !errors.Is(e, cause) {
+		t.Error("Expected errors.Is to find the wrapped cause via ValueErrWithContext")
+	}
+}
@@ -0,0 +1,103 @@
+package throw
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Coder 错误码接口（描述一个可注册的异常编码及其附加信息）
+type Coder interface {
+	// Code 返回异常编码（与 Error.Index 对应）
+	Code() int
+	// HTTPStatus 返回该异常编码对应的HTTP状态码
+	HTTPStatus() int
+	// String 返回面向用户的提示信息
+	String() string
+	// Reference 返回该异常编码的文档/参考链接
+	Reference() string
+}
+
+// defaultCoder Coder接口的默认实现，供内部兜底编码使用
+type defaultCoder struct {
+	code       int
+	httpStatus int
+	message    string
+	reference  string
+}
+
+func (c defaultCoder) Code() int         { return c.code }
+func (c defaultCoder) HTTPStatus() int   { return c.httpStatus }
+func (c defaultCoder) String() string    { return c.message }
+func (c defaultCoder) Reference() string { return c.reference }
+
+var (
+	codersMu sync.RWMutex
+	coders   = map[int]Coder{
+		FallbackErrorIndex: defaultCoder{
+			code:       FallbackErrorIndex,
+			httpStatus: 500,
+			message:    "internal server error",
+			reference:  "",
+		},
+	}
+)
+
+// Register 注册一个异常编码（已存在同编码时直接覆盖）
+// FallbackErrorIndex 为保留编码，不允许通过 Register 覆盖
+func Register(coder Coder) error {
+	if coder == nil {
+		return fmt.Errorf("throw: nil coder")
+	}
+	if coder.Code() == FallbackErrorIndex {
+		return fmt.Errorf("throw: code %d is reserved for the fallback coder", FallbackErrorIndex)
+	}
+	codersMu.Lock()
+	defer codersMu.Unlock()
+	coders[coder.Code()] = coder
+	return nil
+}
+
+// MustRegister 注册一个异常编码，失败时panic（适合在init阶段调用）
+func MustRegister(coder Coder) {
+	if err := Register(coder); err != nil {
+		panic(err)
+	}
+}
+
+// Lookup 根据编码查找已注册的Coder
+func Lookup(index int) (Coder, bool) {
+	codersMu.RLock()
+	defer codersMu.RUnlock()
+	coder, ok := coders[index]
+	return coder, ok
+}
+
+// Coder 解析当前异常对应的Coder，未注册时返回兜底编码（FallbackErrorIndex）
+func (e Error) Coder() Coder {
+	if coder, ok := Lookup(e.Index); ok {
+		return coder
+	}
+	coder, _ := Lookup(FallbackErrorIndex)
+	return coder
+}
+
+// IndexCoded 抛出携带Coder信息的异常（含堆栈信息）
+// 便于HTTP/gRPC等网关层直接根据Coder生成响应，而无需再对Index做switch
+// 直接调用newError（而非委托给Index）以保持与Index一致的调用深度，避免TopFrame错误指向本函数
+func IndexCoded(coder Coder, message string) {
+	index := FallbackErrorIndex
+	if coder != nil {
+		index = coder.Code()
+	}
+	panic(newError(index, message))
+}
+
+// IndexCodedWithContext 带上下文的IndexCoded函数
+func IndexCodedWithContext(coder Coder, message string, key string, ctxValue interface{}) {
+	index := FallbackErrorIndex
+	if coder != nil {
+		index = coder.Code()
+	}
+	e := newError(index, message)
+	panic(e.WithContext(key, ctxValue))
+}
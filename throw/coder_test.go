@@ -0,0 +1,98 @@
+package throw
+
+import "testing"
+
+type testCoder struct {
+	code   int
+	status int
+	msg    string
+	ref    string
+}
+
+func (c testCoder) Code() int         { return c.code }
+func (c testCoder) HTTPStatus() int   { return c.status }
+func (c testCoder) String() string    { return c.msg }
+func (c testCoder) Reference() string { return c.ref }
+
+func TestRegisterAndLookup(t *testing.T) {
+	coder := testCoder{code: 10001, status: 404, msg: "not found", ref: "https://example.com/errors/10001"}
+
+	if err := Register(coder); err != nil {
+		t.Fatalf("Register returned error: %v", err)
+	}
+
+	got, ok := Lookup(10001)
+	if !ok {
+		t.Fatal("Expected Lookup to find registered coder")
+	}
+	if got.HTTPStatus() != 404 {
+		t.Errorf("Expected HTTPStatus 404, got %d", got.HTTPStatus())
+	}
+	if got.Reference() != coder.ref {
+		t.Errorf("Expected reference %s, got %s", coder.ref, got.Reference())
+	}
+}
+
+func TestRegisterReservedIndex(t *testing.T) {
+	err := Register(testCoder{code: FallbackErrorIndex})
+	if err == nil {
+		t.Error("Expected Register to reject FallbackErrorIndex")
+	}
+}
+
+func TestMustRegisterPanicsOnReserved(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Expected MustRegister to panic on reserved index")
+		}
+	}()
+	MustRegister(testCoder{code: FallbackErrorIndex})
+}
+
+func TestErrorCoderFallback(t *testing.T) {
+	e := Error{Index: 999999}
+	coder := e.Coder()
+	if coder.Code() != FallbackErrorIndex {
+		t.Errorf("Expected fallback coder for unregistered index, got code %d", coder.Code())
+	}
+}
+
+func TestIndexCoded(t *testing.T) {
+	coder := testCoder{code: 10002, status: 400, msg: "bad request"}
+	MustRegister(coder)
+
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(Error); ok {
+				if e.Index != 10002 {
+					t.Errorf("Expected index 10002, got %d", e.Index)
+				}
+				if e.Coder().HTTPStatus() != 400 {
+					t.Errorf("Expected HTTPStatus 400, got %d", e.Coder().HTTPStatus())
+				}
+			} else {
+				t.Errorf("Expected Error type, got %T", r)
+			}
+		}
+	}()
+
+	IndexCoded(coder, "bad request")
+}
+
+func TestIndexCodedTopFrameSkipsItself(t *testing.T) {
+	defer func() {
+		e, ok := recover().(Error)
+		if !ok {
+			t.Fatal("Expected Error type")
+		}
+		top := e.TopFrame()
+		if top.Function == "github.com/liu-dc/exception/throw.IndexCoded" {
+			t.Errorf("Expected TopFrame to resolve to the caller, got %q (still pointing at IndexCoded itself)", top.Function)
+		}
+		if top.Function != "github.com/liu-dc/exception/throw.TestIndexCodedTopFrameSkipsItself" {
+			t.Errorf("Expected TopFrame to resolve to this test function, got %q", top.Function)
+		}
+	}()
+
+	IndexCoded(testCoder{code: 10003}, "coded")
+}
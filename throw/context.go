@@ -0,0 +1,27 @@
+package throw
+
+import "context"
+
+// 保留编码：context取消/超时，预留给 try.RunCtx 场景使用
+const (
+	CanceledIndex = FallbackErrorIndex + 1 // context.Canceled
+	DeadlineIndex = FallbackErrorIndex + 2 // context.DeadlineExceeded
+)
+
+// FromContext 检查ctx是否已结束，已结束则panic出对应的Error（CanceledIndex/DeadlineIndex）
+// 用于在try代码块内部替代手写的 select { case <-ctx.Done(): ... } 样板代码
+func FromContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		index := FallbackErrorIndex
+		switch err {
+		case context.Canceled:
+			index = CanceledIndex
+		case context.DeadlineExceeded:
+			index = DeadlineIndex
+		}
+		e := newError(index, err.Error())
+		e.Cause = err
+		panic(e)
+	}
+	return nil
+}
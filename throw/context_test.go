@@ -0,0 +1,34 @@
+package throw
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestFromContextNotDone(t *testing.T) {
+	if err := FromContext(context.Background()); err != nil {
+		t.Errorf("Expected nil, got %v", err)
+	}
+}
+
+func TestFromContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	defer func() {
+		r := recover()
+		e, ok := r.(Error)
+		if !ok {
+			t.Fatalf("Expected Error type, got %T", r)
+		}
+		if e.Index != CanceledIndex {
+			t.Errorf("Expected CanceledIndex, got %d", e.Index)
+		}
+		if !errors.Is(e, context.Canceled) {
+			t.Error("Expected errors.Is to find context.Canceled")
+		}
+	}()
+
+	FromContext(ctx)
+}
@@ -0,0 +1,140 @@
+package throw
+
+import (
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync/atomic"
+)
+
+// Frame 单层调用帧信息（替代对Stack字符串的正则解析）
+type Frame struct {
+	File     string // 源文件路径
+	Line     int    // 源文件行号
+	Function string // 函数全名（含包路径）
+	PC       uintptr
+}
+
+// String 返回单帧的可读形式，格式与标准库runtime.Stack保持一致的风格
+func (f Frame) String() string {
+	return fmt.Sprintf("%s\n\t%s:%d", f.Function, f.File, f.Line)
+}
+
+const (
+	defaultStackDepth = 32
+	defaultStackSkip  = 0
+)
+
+var (
+	captureStack = int32(1) // 默认开启堆栈捕获
+	stackDepth   = int32(defaultStackDepth)
+	stackSkip    = int32(defaultStackSkip)
+)
+
+// CaptureStack 全局开关：关闭后Index等函数不再捕获堆栈/调用帧（热路径性能优先）
+func CaptureStack(enabled bool) {
+	if enabled {
+		atomic.StoreInt32(&captureStack, 1)
+	} else {
+		atomic.StoreInt32(&captureStack, 0)
+	}
+}
+
+// stackCaptureEnabled 私有工具函数：读取当前堆栈捕获开关状态
+func stackCaptureEnabled() bool {
+	return atomic.LoadInt32(&captureStack) != 0
+}
+
+// SetStackDepth 设置单次捕获的最大调用帧数（默认32）
+func SetStackDepth(n int) {
+	if n > 0 {
+		atomic.StoreInt32(&stackDepth, int32(n))
+	}
+}
+
+// SetStackSkip 设置捕获时额外跳过的调用帧数（用于封装了Index的自定义helper）
+func SetStackSkip(n int) {
+	if n >= 0 {
+		atomic.StoreInt32(&stackSkip, int32(n))
+	}
+}
+
+// captureFrames 私有工具函数：通过runtime.Callers捕获结构化调用帧
+// skip：相对于captureFrames自身再额外跳过的帧数
+func captureFrames(skip int) []Frame {
+	depth := int(atomic.LoadInt32(&stackDepth))
+	totalSkip := skip + int(atomic.LoadInt32(&stackSkip))
+
+	pcs := make([]uintptr, depth)
+	// +2：跳过runtime.Callers自身和captureFrames
+	n := runtime.Callers(totalSkip+2, pcs)
+	if n == 0 {
+		return nil
+	}
+
+	framesIter := runtime.CallersFrames(pcs[:n])
+	frames := make([]Frame, 0, n)
+	for {
+		frame, more := framesIter.Next()
+		frames = append(frames, Frame{
+			File:     frame.File,
+			Line:     frame.Line,
+			Function: frame.Function,
+			PC:       frame.PC,
+		})
+		if !more {
+			break
+		}
+	}
+	return frames
+}
+
+// framesToString 私有工具函数：将结构化调用帧拼接为与GetPanicStack风格一致的文本
+func framesToString(frames []Frame) string {
+	if len(frames) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	for i, f := range frames {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(f.String())
+	}
+	return b.String()
+}
+
+// TopFrame 返回最顶层（最贴近抛出点）的调用帧，便于日志快速定位
+func (e Error) TopFrame() Frame {
+	if len(e.Frames) == 0 {
+		return Frame{}
+	}
+	return e.Frames[0]
+}
+
+// StackString 返回堆栈的文本形式；优先使用已捕获的Stack字段，否则从Frames派生
+func (e Error) StackString() string {
+	if e.Stack != "" {
+		return e.Stack
+	}
+	return framesToString(e.Frames)
+}
+
+// errorJSON Error的JSON序列化结构（导出帧数组，供结构化日志管道直接消费）
+type errorJSON struct {
+	Index   int                    `json:"index"`
+	Message string                 `json:"message"`
+	Frames  []Frame                `json:"frames,omitempty"`
+	Context map[string]interface{} `json:"context,omitempty"`
+}
+
+// MarshalJSON 将异常序列化为JSON，Frames以数组形式输出，省去对Stack文本的正则解析
+func (e Error) MarshalJSON() ([]byte, error) {
+	return json.Marshal(errorJSON{
+		Index:   e.Index,
+		Message: e.Message,
+		Frames:  e.Frames,
+		Context: e.Context,
+	})
+}
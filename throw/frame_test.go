@@ -0,0 +1,74 @@
+package throw
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIndexCapturesFrames(t *testing.T) {
+	defer func() {
+		r := recover()
+		e, ok := r.(Error)
+		if !ok {
+			t.Fatalf("Expected Error type, got %T", r)
+		}
+		if len(e.Frames) == 0 {
+			t.Error("Expected Frames to be captured")
+		}
+		if e.TopFrame().Function == "" {
+			t.Error("Expected TopFrame to have a function name")
+		}
+		if e.StackString() == "" {
+			t.Error("Expected StackString to be non-empty")
+		}
+	}()
+
+	Index(1, "boom")
+}
+
+func TestCaptureStackDisabled(t *testing.T) {
+	CaptureStack(false)
+	defer CaptureStack(true)
+
+	defer func() {
+		r := recover()
+		e, ok := r.(Error)
+		if !ok {
+			t.Fatalf("Expected Error type, got %T", r)
+		}
+		if len(e.Frames) != 0 {
+			t.Error("Expected no Frames to be captured when CaptureStack(false)")
+		}
+	}()
+
+	Index(1, "boom")
+}
+
+func TestErrorMarshalJSON(t *testing.T) {
+	defer func() {
+		r := recover()
+		e, ok := r.(Error)
+		if !ok {
+			t.Fatalf("Expected Error type, got %T", r)
+		}
+		data, err := json.Marshal(e)
+		if err != nil {
+			t.Fatalf("MarshalJSON failed: %v", err)
+		}
+		var decoded struct {
+			Index  int     `json:"index"`
+			Frames []Frame `json:"frames"`
+		}
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("Unmarshal failed: %v", err)
+		}
+		if decoded.Index != 1 {
+			t.Errorf("Expected index 1, got %d", decoded.Index)
+		}
+		if len(decoded.Frames) == 0 {
+			t.Error("Expected frames array in JSON output")
+		}
+	}()
+
+	Index(1, "boom")
+}
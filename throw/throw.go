@@ -14,7 +14,10 @@ type Error struct {
 	Index   int                    // 异常编码（自定义分类）
 	Message string                 // 异常描述信息
 	Stack   string                 // 异常堆栈（新增：便于问题排查）
+	Frames  []Frame                // 结构化调用帧（新增：替代对Stack文本的解析）
 	Context map[string]interface{} // 异常上下文（新增：携带额外信息）
+	Cause   error                  // 原始异常（新增：保留被包装的单一异常，支持errors.Unwrap）
+	Causes  []error                // 原始异常集合（新增：支持Join场景下的多异常链）
 }
 
 // WithContext 为异常添加上下文
@@ -75,26 +78,29 @@ func GetPanicStack() string {
 	return string(buf[:n])
 }
 
-// Index 抛出异常（含堆栈信息，便于排查）
-func Index(index int, message string) {
-	// 获取当前堆栈信息（跳过Throw函数本身，从调用者开始）
-	stack := GetPanicStack()
-	panic(Error{
+// newError 私有工具函数：构建携带堆栈信息的Error（供Index等函数复用）
+func newError(index int, message string) Error {
+	e := Error{
 		Index:   index,
 		Message: message,
-		Stack:   stack,
-	})
+	}
+	// 跳过newError和调用它的Index/Wrap等函数，从真正的调用者开始捕获
+	if stackCaptureEnabled() {
+		e.Frames = captureFrames(2)
+		e.Stack = framesToString(e.Frames)
+	}
+	return e
+}
+
+// Index 抛出异常（含堆栈信息，便于排查）
+func Index(index int, message string) {
+	panic(newError(index, message))
 }
 
 // IndexWithContext 带上下文的Index函数
 func IndexWithContext(index int, message string, key string, ctxValue interface{}) {
-	stack := GetPanicStack()
-	panic(Error{
-		Index:   index,
-		Message: message,
-		Stack:   stack,
-		Context: map[string]interface{}{key: ctxValue},
-	})
+	e := newError(index, message)
+	panic(e.WithContext(key, ctxValue))
 }
 
 // IndexNoStack 抛出异常（不含堆栈信息，性能优先）
@@ -127,14 +133,17 @@ func NewWithContext(message string, key string, ctxValue interface{}) {
 
 func Err(err error) {
 	if err != nil {
-		Index(FallbackErrorIndex, err.Error()) //使用传入的 index 作为异常码
+		Wrap(err, FallbackErrorIndex, err.Error()) //使用 FallbackErrorIndex 作为异常码，同时保留原始异常
 	}
 }
 
 // ErrWithContext 带上下文的Err函数
 func ErrWithContext(err error, key string, ctxValue interface{}) {
 	if err != nil {
-		IndexWithContext(FallbackErrorIndex, err.Error(), key, ctxValue)
+		e := newError(FallbackErrorIndex, err.Error())
+		e.Cause = err
+		e = e.WithContext(key, ctxValue)
+		panic(e)
 	}
 }
 
@@ -193,7 +202,7 @@ func FalseIndexWithContext(index int, value bool, message string, key string, ct
 func FuncErr(fn func() error) {
 	err := fn()
 	if err != nil {
-		Index(FallbackErrorIndex, err.Error()) //使用传入的 FallbackErrorIndex 作为异常码
+		Wrap(err, FallbackErrorIndex, err.Error()) //使用传入的 FallbackErrorIndex 作为异常码，同时保留原始异常
 	}
 }
 
@@ -201,13 +210,13 @@ func FuncErr(fn func() error) {
 func FuncErrWithContext(fn func() error, key string, ctxValue interface{}) {
 	err := fn()
 	if err != nil {
-		IndexWithContext(FallbackErrorIndex, err.Error(), key, ctxValue)
+		WrapWithContext(err, FallbackErrorIndex, err.Error(), key, ctxValue)
 	}
 }
 
 func ValueErr[T any](value T, err error) T {
 	if err != nil {
-		Index(FallbackErrorIndex, err.Error()) //使用传入的 index 作为异常码
+		Wrap(err, FallbackErrorIndex, err.Error()) //使用 FallbackErrorIndex 作为异常码，同时保留原始异常
 	}
 	return value
 }
@@ -215,20 +224,20 @@ func ValueErr[T any](value T, err error) T {
 // ValueErrWithContext 带上下文的ValueErr函数
 func ValueErrWithContext[T any](value T, err error, key string, ctxValue interface{}) T {
 	if err != nil {
-		IndexWithContext(FallbackErrorIndex, err.Error(), key, ctxValue)
+		WrapWithContext(err, FallbackErrorIndex, err.Error(), key, ctxValue)
 	}
 	return value
 }
 
 func IndexErr(index int, err error) {
 	if err != nil {
-		Index(index, err.Error()) //使用传入的 index 作为异常码
+		Wrap(err, index, err.Error()) //使用传入的 index 作为异常码，同时保留原始异常
 	}
 }
 
 // IndexErrWithContext 带上下文的IndexErr函数
 func IndexErrWithContext(index int, err error, key string, ctxValue interface{}) {
 	if err != nil {
-		IndexWithContext(index, err.Error(), key, ctxValue)
+		WrapWithContext(err, index, err.Error(), key, ctxValue)
 	}
 }
@@ -0,0 +1,94 @@
+package try
+
+import "github.com/liu-dc/exception/throw"
+
+// bundleGroup Bundle内部使用：保留一个Group及其处理器的绑定关系
+type bundleGroup struct {
+	group   Group
+	handler ErrorHandler
+}
+
+// Bundle 预配置的一组处理规则（Index/Group/Catch/Unknown/Filter/Middleware）
+// 用于在多个Try之间复用同一套异常处理策略（如"web-request"场景下的4xx/5xx响应映射）
+type Bundle struct {
+	indexes     map[int]ErrorHandler
+	groups      []bundleGroup
+	catch       ErrorHandler
+	unknown     ErrorHandler
+	filters     []func(throw.Error) bool
+	middlewares []Middleware
+}
+
+// NewBundle 创建一个空的Bundle
+func NewBundle() *Bundle {
+	return &Bundle{
+		indexes: make(map[int]ErrorHandler),
+	}
+}
+
+// Index 向Bundle中添加特定异常码处理器（支持链式调用）
+func (b *Bundle) Index(index int, handler ErrorHandler) *Bundle {
+	if handler != nil && index != 0 && index != throw.FallbackErrorIndex {
+		b.indexes[index] = handler
+	}
+	return b
+}
+
+// Group 向Bundle中添加异常分组处理器（支持链式调用）
+func (b *Bundle) Group(group Group, handler ErrorHandler) *Bundle {
+	if handler != nil && len(group) > 0 {
+		b.groups = append(b.groups, bundleGroup{group: group, handler: handler})
+	}
+	return b
+}
+
+// Catch 设置Bundle的全局处理器（支持链式调用）
+func (b *Bundle) Catch(handler ErrorHandler) *Bundle {
+	b.catch = handler
+	return b
+}
+
+// Unknown 设置Bundle的兜底处理器（支持链式调用）
+func (b *Bundle) Unknown(handler ErrorHandler) *Bundle {
+	b.unknown = handler
+	return b
+}
+
+// Filter 向Bundle中添加异常过滤器（支持链式调用）
+func (b *Bundle) Filter(filter func(throw.Error) bool) *Bundle {
+	if filter != nil {
+		b.filters = append(b.filters, filter)
+	}
+	return b
+}
+
+// Use 向Bundle中添加中间件（支持链式调用）
+func (b *Bundle) Use(mw ...Middleware) *Bundle {
+	b.middlewares = append(b.middlewares, mw...)
+	return b
+}
+
+// Apply 将Bundle中预配置的处理规则应用到当前Try上（支持链式调用）
+// 可与Try自身的Index/Catch/Group等调用自由组合，Apply不会清空已有配置
+func (ts *Try) Apply(bundle *Bundle) *Try {
+	if bundle == nil {
+		return ts
+	}
+	for index, handler := range bundle.indexes {
+		ts.Index(index, handler)
+	}
+	for _, g := range bundle.groups {
+		ts.Group(g.group, g.handler)
+	}
+	if bundle.catch != nil {
+		ts.Catch(bundle.catch)
+	}
+	if bundle.unknown != nil {
+		ts.Unknown(bundle.unknown)
+	}
+	for _, filter := range bundle.filters {
+		ts.Filter(filter)
+	}
+	ts.Use(bundle.middlewares...)
+	return ts
+}
@@ -0,0 +1,56 @@
+package try
+
+import (
+	"context"
+
+	"github.com/liu-dc/exception/throw"
+)
+
+// ErrorHandlerCtx 携带上下文的异常处理函数签名，便于处理器内部感知取消/超时或传递trace信息
+type ErrorHandlerCtx func(context.Context, throw.Error)
+
+// RunCtx 入口函数：创建Try实例并绑定核心逻辑，核心逻辑可接收关联的context.Context
+// fn执行前会先检查ctx是否已结束（取消/超时），已结束时不再执行fn，直接转入异常处理流程
+func RunCtx(ctx context.Context, fn func(ctx context.Context)) *Try {
+	ts := Run(func() {
+		fn(ctx)
+	})
+	ts.ctx = ctx
+	return ts
+}
+
+// IndexCtx 注册指定异常码的针对性处理器（携带上下文，支持链式调用）
+func (ts *Try) IndexCtx(index int, handler ErrorHandlerCtx) *Try {
+	if handler != nil && index != 0 && index != throw.FallbackErrorIndex {
+		ts.specificHandlersCtx[index] = handler
+	}
+	return ts
+}
+
+// CatchCtx 注册全局处理器（携带上下文，支持链式调用）
+func (ts *Try) CatchCtx(handler ErrorHandlerCtx) *Try {
+	if handler != nil {
+		ts.globalHandlerCtx = handler
+	}
+	return ts
+}
+
+// UnknownCtx 注册兜底处理器（携带上下文，支持链式调用）
+func (ts *Try) UnknownCtx(handler ErrorHandlerCtx) *Try {
+	if handler != nil {
+		ts.fallbackHandlerCtx = handler
+	}
+	return ts
+}
+
+// GroupCtx 注册异常分组处理器（携带上下文，支持链式调用）
+func (ts *Try) GroupCtx(group Group, handler ErrorHandlerCtx) *Try {
+	if handler != nil && len(group) > 0 {
+		for _, index := range group {
+			if index != 0 && index != throw.FallbackErrorIndex {
+				ts.groupHandlersCtx[index] = append(ts.groupHandlersCtx[index], handler)
+			}
+		}
+	}
+	return ts
+}
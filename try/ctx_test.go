@@ -0,0 +1,59 @@
+package try
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liu-dc/exception/throw"
+)
+
+func TestRunCtxPropagatesContext(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "value")
+	var seen string
+
+	RunCtx(ctx, func(ctx context.Context) {
+		seen = ctx.Value(key{}).(string)
+	}).Do()
+
+	if seen != "value" {
+		t.Errorf("Expected context value to propagate, got %q", seen)
+	}
+}
+
+func TestRunCtxSkipsWhenAlreadyCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	fnCalled := false
+	canceledCaught := false
+
+	RunCtx(ctx, func(ctx context.Context) {
+		fnCalled = true
+	}).IndexCtx(throw.CanceledIndex, func(ctx context.Context, err throw.Error) {
+		canceledCaught = true
+	}).Do()
+
+	if fnCalled {
+		t.Error("Expected fn not to run when context is already canceled")
+	}
+	if !canceledCaught {
+		t.Error("Expected CanceledIndex handler to be invoked")
+	}
+}
+
+func TestCatchCtxReceivesContext(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "trace-id")
+	var gotValue string
+
+	RunCtx(ctx, func(ctx context.Context) {
+		throw.Index(500, "boom")
+	}).CatchCtx(func(ctx context.Context, err throw.Error) {
+		gotValue = ctx.Value(key{}).(string)
+	}).Do()
+
+	if gotValue != "trace-id" {
+		t.Errorf("Expected context value in CatchCtx handler, got %q", gotValue)
+	}
+}
@@ -0,0 +1,74 @@
+// Package httptry 将try/throw的异常处理模型接入net/http，提供统一的错误响应封装
+package httptry
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/liu-dc/exception/throw"
+	"github.com/liu-dc/exception/try"
+)
+
+// Renderer 将捕获到的throw.Error渲染为HTTP响应，便于替换为protobuf、纯文本等自定义格式
+type Renderer func(w http.ResponseWriter, r *http.Request, err throw.Error)
+
+// Logger 可选的堆栈记录钩子，在渲染响应前调用，便于接入现有日志系统
+var Logger func(err throw.Error)
+
+var renderer Renderer = defaultRenderer
+
+// SetRenderer 覆盖默认的响应渲染方式
+func SetRenderer(r Renderer) {
+	if r != nil {
+		renderer = r
+	}
+}
+
+// envelope 默认的JSON错误响应体
+type envelope struct {
+	Code      int                    `json:"code"`
+	Message   string                 `json:"message"`
+	Reference string                 `json:"reference,omitempty"`
+	Context   map[string]interface{} `json:"context,omitempty"`
+}
+
+// defaultRenderer 默认渲染器：依据Coder注册表决定状态码、用户提示信息和文档链接
+func defaultRenderer(w http.ResponseWriter, r *http.Request, err throw.Error) {
+	coder := err.Coder()
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(coder.HTTPStatus())
+	_ = json.NewEncoder(w).Encode(envelope{
+		Code:      err.Index,
+		Message:   coder.String(),
+		Reference: coder.Reference(),
+		Context:   err.Context,
+	})
+}
+
+// recoverAndRender 私有辅助函数：执行fn，捕获throw.Error并交由renderer输出响应
+func recoverAndRender(w http.ResponseWriter, r *http.Request, fn func()) {
+	try.Run(fn).Catch(func(err throw.Error) {
+		if Logger != nil {
+			Logger(err)
+		}
+		renderer(w, r, err)
+	}).Do()
+}
+
+// Handler 将可能panic出throw.Error的处理函数包装为标准http.HandlerFunc
+func Handler(fn func(w http.ResponseWriter, r *http.Request)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		recoverAndRender(w, r, func() {
+			fn(w, r)
+		})
+	}
+}
+
+// Middleware 捕获下游http.Handler中panic出的throw.Error，统一输出错误响应
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		recoverAndRender(w, r, func() {
+			next.ServeHTTP(w, r)
+		})
+	})
+}
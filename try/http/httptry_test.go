@@ -0,0 +1,83 @@
+package httptry
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/liu-dc/exception/throw"
+)
+
+type notFoundCoder struct{}
+
+func (notFoundCoder) Code() int         { return 20404 }
+func (notFoundCoder) HTTPStatus() int   { return http.StatusNotFound }
+func (notFoundCoder) String() string    { return "resource not found" }
+func (notFoundCoder) Reference() string { return "https://example.com/errors/20404" }
+
+func TestHandlerRendersRegisteredCoder(t *testing.T) {
+	throw.MustRegister(notFoundCoder{})
+
+	handler := Handler(func(w http.ResponseWriter, r *http.Request) {
+		throw.IndexCoded(notFoundCoder{}, "user 42 not found")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", rec.Code)
+	}
+
+	var body envelope
+	if err := json.NewDecoder(rec.Body).Decode(&body); err != nil {
+		t.Fatalf("Failed to decode response body: %v", err)
+	}
+	if body.Code != 20404 {
+		t.Errorf("Expected code 20404, got %d", body.Code)
+	}
+	if body.Reference != "https://example.com/errors/20404" {
+		t.Errorf("Expected reference to be set, got %q", body.Reference)
+	}
+}
+
+func TestMiddlewareCatchesDownstreamPanic(t *testing.T) {
+	downstream := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		throw.Index(throw.FallbackErrorIndex, "unexpected failure")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	Middleware(downstream).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("Expected status 500, got %d", rec.Code)
+	}
+}
+
+func TestSetRenderer(t *testing.T) {
+	defer SetRenderer(defaultRenderer)
+
+	called := false
+	SetRenderer(func(w http.ResponseWriter, r *http.Request, err throw.Error) {
+		called = true
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	handler := Handler(func(w http.ResponseWriter, r *http.Request) {
+		throw.Index(throw.FallbackErrorIndex, "boom")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+
+	if !called {
+		t.Error("Expected custom renderer to be called")
+	}
+	if rec.Code != http.StatusTeapot {
+		t.Errorf("Expected status 418, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,43 @@
+package try
+
+import (
+	"context"
+
+	"github.com/liu-dc/exception/throw"
+)
+
+// Middleware 处理器中间件：包装一个ErrorHandler，生成附加了横切逻辑（埋点、链路追踪、限流告警等）的新处理器
+type Middleware func(next ErrorHandler) ErrorHandler
+
+// Use 注册中间件（支持链式调用），按注册顺序由外到内包裹，对ts上注册的所有处理器生效
+// 包括通过IndexCtx/CatchCtx/UnknownCtx/GroupCtx注册的携带上下文的处理器
+// 例如 Use(metrics, tracing) 的执行顺序为 metrics -> tracing -> 实际处理器
+func (ts *Try) Use(mw ...Middleware) *Try {
+	ts.middlewares = append(ts.middlewares, mw...)
+	return ts
+}
+
+// wrap 私有辅助方法：为handler套上已注册的中间件链
+func (ts *Try) wrap(handler ErrorHandler) ErrorHandler {
+	return chainMiddlewares(handler, ts.middlewares)
+}
+
+// wrapCtx 私有辅助方法：为携带上下文的handler套上已注册的中间件链
+// Middleware本身只认识ErrorHandler，因此通过闭包把ctx固定下来，复用同一条中间件链
+func (ts *Try) wrapCtx(handler ErrorHandlerCtx) ErrorHandlerCtx {
+	return func(ctx context.Context, err throw.Error) {
+		chainMiddlewares(func(e throw.Error) {
+			handler(ctx, e)
+		}, ts.middlewares)(err)
+	}
+}
+
+// chainMiddlewares 包级辅助函数：按注册顺序由外到内组合中间件
+func chainMiddlewares(handler ErrorHandler, mws []Middleware) ErrorHandler {
+	for i := len(mws) - 1; i >= 0; i-- {
+		if mws[i] != nil {
+			handler = mws[i](handler)
+		}
+	}
+	return handler
+}
@@ -0,0 +1,92 @@
+package try
+
+import (
+	"context"
+	"testing"
+
+	"github.com/liu-dc/exception/throw"
+)
+
+func TestUseWrapsHandler(t *testing.T) {
+	var order []string
+
+	metrics := func(next ErrorHandler) ErrorHandler {
+		return func(err throw.Error) {
+			order = append(order, "metrics-before")
+			next(err)
+			order = append(order, "metrics-after")
+		}
+	}
+	tracing := func(next ErrorHandler) ErrorHandler {
+		return func(err throw.Error) {
+			order = append(order, "tracing-before")
+			next(err)
+			order = append(order, "tracing-after")
+		}
+	}
+
+	Run(func() {
+		throw.Index(500, "boom")
+	}).Use(metrics, tracing).Index(500, func(err throw.Error) {
+		order = append(order, "handler")
+	}).Do()
+
+	expected := []string{"metrics-before", "tracing-before", "handler", "tracing-after", "metrics-after"}
+	if len(order) != len(expected) {
+		t.Fatalf("Expected order %v, got %v", expected, order)
+	}
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Errorf("Expected order %v, got %v", expected, order)
+			break
+		}
+	}
+}
+
+func TestUseWrapsCtxHandlers(t *testing.T) {
+	var order []string
+
+	metrics := func(next ErrorHandler) ErrorHandler {
+		return func(err throw.Error) {
+			order = append(order, "metrics-before")
+			next(err)
+			order = append(order, "metrics-after")
+		}
+	}
+
+	RunCtx(context.Background(), func(ctx context.Context) {
+		throw.Index(500, "boom")
+	}).Use(metrics).CatchCtx(func(ctx context.Context, err throw.Error) {
+		order = append(order, "handler")
+	}).Do()
+
+	expected := []string{"metrics-before", "handler", "metrics-after"}
+	if len(order) != len(expected) {
+		t.Fatalf("Expected order %v, got %v", expected, order)
+	}
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Errorf("Expected order %v, got %v", expected, order)
+			break
+		}
+	}
+}
+
+func TestBundleApply(t *testing.T) {
+	var handled, caught bool
+
+	bundle := NewBundle().
+		Index(404, func(err throw.Error) { handled = true }).
+		Catch(func(err throw.Error) { caught = true })
+
+	Run(func() {
+		throw.Index(404, "not found")
+	}).Apply(bundle).Do()
+
+	if !handled {
+		t.Error("Expected bundle's Index handler to be applied")
+	}
+	if !caught {
+		t.Error("Expected bundle's Catch handler to be applied")
+	}
+}
@@ -1,6 +1,8 @@
 package try
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/liu-dc/exception/throw"
@@ -11,12 +13,18 @@ type ErrorHandler func(throw.Error)
 
 // Try 核心控制结构（管理try逻辑和异常处理器）
 type Try struct {
-	specificHandlers map[int]ErrorHandler     // 特定异常码处理器
-	groupHandlers    map[int][]ErrorHandler   // 异常分组处理器（新增）
-	globalHandler    ErrorHandler             // 全局处理器
-	fallbackHandler  ErrorHandler             // 兜底处理器
-	tryFunc          func()                   // 待执行的核心逻辑
-	filters          []func(throw.Error) bool // 异常过滤器（新增）
+	ctx                 context.Context           // 关联的上下文（新增：由RunCtx设置，默认为context.Background()）
+	specificHandlers    map[int]ErrorHandler      // 特定异常码处理器
+	groupHandlers       map[int][]ErrorHandler    // 异常分组处理器（新增）
+	globalHandler       ErrorHandler              // 全局处理器
+	fallbackHandler     ErrorHandler              // 兜底处理器
+	specificHandlersCtx map[int]ErrorHandlerCtx   // 特定异常码处理器（携带上下文，新增）
+	groupHandlersCtx    map[int][]ErrorHandlerCtx // 异常分组处理器（携带上下文，新增）
+	globalHandlerCtx    ErrorHandlerCtx           // 全局处理器（携带上下文，新增）
+	fallbackHandlerCtx  ErrorHandlerCtx           // 兜底处理器（携带上下文，新增）
+	tryFunc             func()                    // 待执行的核心逻辑
+	filters             []func(throw.Error) bool  // 异常过滤器（新增）
+	middlewares         []Middleware              // 处理器中间件链（新增）
 }
 
 // Group 异常分组类型
@@ -25,12 +33,15 @@ type Group []int
 // Run 入口函数：创建Try实例并绑定核心逻辑
 func Run(tryHandler func()) *Try {
 	return &Try{
-		specificHandlers: make(map[int]ErrorHandler),
-		groupHandlers:    make(map[int][]ErrorHandler),
-		globalHandler:    nil,
-		fallbackHandler:  nil,
-		tryFunc:          tryHandler,
-		filters:          make([]func(throw.Error) bool, 0),
+		ctx:                 context.Background(),
+		specificHandlers:    make(map[int]ErrorHandler),
+		groupHandlers:       make(map[int][]ErrorHandler),
+		globalHandler:       nil,
+		fallbackHandler:     nil,
+		specificHandlersCtx: make(map[int]ErrorHandlerCtx),
+		groupHandlersCtx:    make(map[int][]ErrorHandlerCtx),
+		tryFunc:             tryHandler,
+		filters:             make([]func(throw.Error) bool, 0),
 	}
 }
 
@@ -102,7 +113,12 @@ func (ts *Try) executeHandlers(err throw.Error) (handled bool, filtered bool) {
 
 	// 步骤1：执行特定异常码处理器（精准匹配优先）
 	if handler, exists := ts.specificHandlers[err.Index]; exists {
-		handler(err)
+		ts.wrap(handler)(err)
+		hasSpecificHandler = true
+		handled = true
+	}
+	if handler, exists := ts.specificHandlersCtx[err.Index]; exists {
+		ts.wrapCtx(handler)(ts.ctx, err)
 		hasSpecificHandler = true
 		handled = true
 	}
@@ -110,20 +126,34 @@ func (ts *Try) executeHandlers(err throw.Error) (handled bool, filtered bool) {
 	// 步骤2：执行异常分组处理器
 	if handlers, exists := ts.groupHandlers[err.Index]; exists {
 		for _, handler := range handlers {
-			handler(err)
+			ts.wrap(handler)(err)
+			handled = true
+		}
+	}
+	if handlers, exists := ts.groupHandlersCtx[err.Index]; exists {
+		for _, handler := range handlers {
+			ts.wrapCtx(handler)(ts.ctx, err)
 			handled = true
 		}
 	}
 
 	// 步骤3：执行兜底处理器（所有未知异常必执行）
 	if !hasSpecificHandler && ts.fallbackHandler != nil {
-		ts.fallbackHandler(err)
+		ts.wrap(ts.fallbackHandler)(err)
+		handled = true
+	}
+	if !hasSpecificHandler && ts.fallbackHandlerCtx != nil {
+		ts.wrapCtx(ts.fallbackHandlerCtx)(ts.ctx, err)
 		handled = true
 	}
 
 	// 步骤4：执行全局处理器（所有异常必执行，如日志、监控）
 	if ts.globalHandler != nil {
-		ts.globalHandler(err)
+		ts.wrap(ts.globalHandler)(err)
+		handled = true
+	}
+	if ts.globalHandlerCtx != nil {
+		ts.wrapCtx(ts.globalHandlerCtx)(ts.ctx, err)
 		handled = true
 	}
 
@@ -132,6 +162,11 @@ func (ts *Try) executeHandlers(err throw.Error) (handled bool, filtered bool) {
 
 // normalizeError 私有辅助方法：统一异常格式（确保所有panic都转为throw.Error，含堆栈）
 func (ts *Try) normalizeError(recoverObj interface{}) throw.Error {
+	return normalizePanic(recoverObj)
+}
+
+// normalizePanic 包级辅助函数：统一异常格式（供Try和RunE/CatchE共用）
+func normalizePanic(recoverObj interface{}) throw.Error {
 	// 已是throw.Error类型，直接返回（保留原始异常信息）
 	if e, ok := recoverObj.(throw.Error); ok {
 		return e
@@ -139,12 +174,29 @@ func (ts *Try) normalizeError(recoverObj interface{}) throw.Error {
 
 	// 非预期panic：包装为兜底异常，补充堆栈和类型信息（便于排查未知问题）
 	stack := throw.GetPanicStack()
-	return throw.Error{
-		Index:   throw.FallbackErrorIndex,
+	index := throw.FallbackErrorIndex
+
+	// 若panic值是（或包装了）context.Canceled/context.DeadlineExceeded，归入对应的保留编码
+	if cause, ok := recoverObj.(error); ok {
+		switch {
+		case errors.Is(cause, context.Canceled):
+			index = throw.CanceledIndex
+		case errors.Is(cause, context.DeadlineExceeded):
+			index = throw.DeadlineIndex
+		}
+	}
+
+	e := throw.Error{
+		Index:   index,
 		Message: fmt.Sprintf("unexpected panic: %v (type: %T)", recoverObj, recoverObj),
 		Stack:   stack,
 		Context: make(map[string]interface{}), // 初始化空上下文
 	}
+	// 若panic值本身已实现error接口，保留原始异常，支持errors.Is/As继续沿链追溯
+	if cause, ok := recoverObj.(error); ok {
+		e.Cause = cause
+	}
+	return e
 }
 
 // Finally 执行核心逻辑+捕获异常+执行最终清理逻辑（核心方法）
@@ -181,7 +233,10 @@ func (ts *Try) Finally(finally func()) {
 		}
 	}()
 
-	// 执行核心业务逻辑
+	// 执行核心业务逻辑前检查ctx是否已结束，避免在已取消/超时的上下文中仍然执行
+	if ts.ctx != nil {
+		throw.FromContext(ts.ctx)
+	}
 	ts.tryFunc()
 }
 
@@ -189,3 +244,38 @@ func (ts *Try) Finally(finally func()) {
 func (ts *Try) Do() {
 	ts.Finally(nil)
 }
+
+// RunE 执行fn，将其panic恢复为error返回，而非继续panic（便于与errgroup等惯用Go代码混用）
+// fn正常返回的error会原样透传；fn内部panic出的throw.Error/其他值会被统一规整后返回
+func RunE(fn func() error) (err error) {
+	defer func() {
+		if recoverObj := recover(); recoverObj != nil {
+			if (recoverObj == throw.Pass{}) {
+				return
+			}
+			err = normalizePanic(recoverObj)
+		}
+	}()
+	return fn()
+}
+
+// CatchE 执行fn（语义同RunE），当得到的error为throw.Error时依次调用handlers（用于日志、监控等副作用）
+// fn直接返回的非throw.Error类型的error不会触发handlers，原样返回
+// 最终仍返回error，交由调用方决定是否继续向上传播
+func CatchE(fn func() error, handlers ...ErrorHandler) error {
+	err := RunE(fn)
+	if err == nil {
+		return nil
+	}
+
+	te, ok := err.(throw.Error)
+	if !ok {
+		return err
+	}
+	for _, handler := range handlers {
+		if handler != nil {
+			handler(te)
+		}
+	}
+	return te
+}
@@ -1,6 +1,7 @@
 package try
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/liu-dc/exception/throw"
@@ -171,3 +172,59 @@ func TestTryContextSupport(t *testing.T) {
 		t.Error("Expected context to be passed correctly")
 	}
 }
+
+func TestRunEReturnsError(t *testing.T) {
+	err := RunE(func() error {
+		throw.Index(500, "server error")
+		return nil
+	})
+
+	e, ok := err.(throw.Error)
+	if !ok {
+		t.Fatalf("Expected throw.Error, got %T", err)
+	}
+	if e.Index != 500 {
+		t.Errorf("Expected index 500, got %d", e.Index)
+	}
+}
+
+func TestRunEPassesThroughReturnedError(t *testing.T) {
+	err := RunE(func() error {
+		return fmt.Errorf("plain error")
+	})
+
+	if err == nil || err.Error() != "plain error" {
+		t.Errorf("Expected plain error to pass through, got %v", err)
+	}
+}
+
+func TestRunENoError(t *testing.T) {
+	err := RunE(func() error {
+		return nil
+	})
+
+	if err != nil {
+		t.Errorf("Expected nil error, got %v", err)
+	}
+}
+
+func TestCatchEInvokesHandlers(t *testing.T) {
+	handlerCalled := false
+
+	err := CatchE(func() error {
+		throw.Index(404, "not found")
+		return nil
+	}, func(e throw.Error) {
+		handlerCalled = true
+		if e.Index != 404 {
+			t.Errorf("Expected index 404, got %d", e.Index)
+		}
+	})
+
+	if !handlerCalled {
+		t.Error("Expected handler to be called")
+	}
+	if err == nil {
+		t.Error("Expected CatchE to still return the error")
+	}
+}